@@ -0,0 +1,29 @@
+package main
+
+// pause-schedule, resume-schedule, and migrate-schedule entries for the ownCommands dispatch
+// table declared alongside the rest of resticprofile's own commands (schedule, unschedule,
+// status-schedule, run-schedule, and the restic-passthrough commands) in commands.go. That table
+// isn't part of this chunk's tree, so it's not reproduced here; these entries are appended to it
+// from this file's init().
+func init() {
+	ownCommands = append(ownCommands, []ownCommand{
+		{
+			name:              "pause-schedule",
+			description:       "pause a scheduled profile without removing its registration",
+			action:            pauseSchedule,
+			needConfiguration: true,
+		},
+		{
+			name:              "resume-schedule",
+			description:       "resume a previously paused scheduled profile",
+			action:            resumeSchedule,
+			needConfiguration: true,
+		},
+		{
+			name:              "migrate-schedule",
+			description:       "migrate schedules from the legacy per-profile naming to the current config-scoped one",
+			action:            migrateSchedule,
+			needConfiguration: true,
+		},
+	}...)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagValue(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		flag      string
+		wantValue string
+		wantOk    bool
+	}{
+		{"present", []string{"--at", "2026-08-01T03:00:00Z"}, "--at", "2026-08-01T03:00:00Z", true},
+		{"presentAmongOthers", []string{"--all", "--in", "1h", "--no-start"}, "--in", "1h", true},
+		{"missing", []string{"--all"}, "--at", "", false},
+		{"missingValue", []string{"--at"}, "--at", "", false},
+		{"empty", nil, "--at", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, ok := flagValue(tc.args, tc.flag)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantValue, value)
+		})
+	}
+}
+
+func TestParseOneShotSchedule(t *testing.T) {
+	t.Run("neitherFlag", func(t *testing.T) {
+		runAt, err := parseOneShotSchedule([]string{"--all"})
+		require.NoError(t, err)
+		assert.Empty(t, runAt)
+	})
+
+	t.Run("atFutureTime", func(t *testing.T) {
+		future := time.Now().Add(time.Hour).Format(time.RFC3339)
+		runAt, err := parseOneShotSchedule([]string{"--at", future})
+		require.NoError(t, err)
+		assert.Equal(t, future, runAt)
+	})
+
+	t.Run("atPastTime", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+		_, err := parseOneShotSchedule([]string{"--at", past})
+		assert.Error(t, err)
+	})
+
+	t.Run("atInvalidTime", func(t *testing.T) {
+		_, err := parseOneShotSchedule([]string{"--at", "not-a-time"})
+		assert.Error(t, err)
+	})
+
+	t.Run("inPositiveDuration", func(t *testing.T) {
+		before := time.Now()
+		runAt, err := parseOneShotSchedule([]string{"--in", "30m"})
+		require.NoError(t, err)
+
+		got, err := time.Parse(time.RFC3339, runAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, before.Add(30*time.Minute), got, time.Second)
+	})
+
+	t.Run("inNonPositiveDuration", func(t *testing.T) {
+		_, err := parseOneShotSchedule([]string{"--in", "0s"})
+		assert.Error(t, err)
+	})
+
+	t.Run("inInvalidDuration", func(t *testing.T) {
+		_, err := parseOneShotSchedule([]string{"--in", "not-a-duration"})
+		assert.Error(t, err)
+	})
+
+	t.Run("atMissingValue", func(t *testing.T) {
+		_, err := parseOneShotSchedule([]string{"--no-start", "--at"})
+		assert.Error(t, err)
+	})
+
+	t.Run("inMissingValue", func(t *testing.T) {
+		_, err := parseOneShotSchedule([]string{"--no-start", "--in"})
+		assert.Error(t, err)
+	})
+}
+
+func TestOutputFormat(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"none", []string{"--all"}, ""},
+		{"spaceForm", []string{"--output", "json"}, "json"},
+		{"equalsForm", []string{"--output=yaml"}, "yaml"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, outputFormat(tc.args))
+		})
+	}
+}